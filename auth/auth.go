@@ -0,0 +1,75 @@
+// Package auth issues the JWTs that middleware.NewJWTMiddleware verifies.
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/middleware"
+)
+
+// Credentials is the login/signup request body.
+type Credentials struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// Service issues signed access tokens for uber-core users.
+type Service struct {
+	cfg *config.Config
+}
+
+// NewService builds the auth Service.
+func NewService(cfg *config.Config) *Service {
+	return &Service{cfg: cfg}
+}
+
+// Login validates credentials (fake: any non-empty email/password pair
+// succeeds) and issues a signed access token with the "user" role.
+func (s *Service) Login(w http.ResponseWriter, r *http.Request) {
+	s.issueToken(w, r, []string{"user"})
+}
+
+// Signup behaves like Login but is exposed as its own endpoint so clients
+// don't depend on login and signup sharing an implementation.
+func (s *Service) Signup(w http.ResponseWriter, r *http.Request) {
+	s.issueToken(w, r, []string{"user"})
+}
+
+func (s *Service) issueToken(w http.ResponseWriter, r *http.Request, roles []string) {
+	var creds Credentials
+	if err := json.NewDecoder(r.Body).Decode(&creds); err != nil || creds.Email == "" || creds.Password == "" {
+		writeError(w, http.StatusBadRequest, "email and password are required")
+		return
+	}
+
+	claims := middleware.Claims{
+		UserID: creds.Email,
+		Email:  creds.Email,
+		Roles:  roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.cfg.JWTSecret))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to sign token")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `{"token":"%s"}`, token)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error":"%s"}`, msg)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"net/http"
+
+	"code.uber.internal/go/uber-core/auth"
+)
+
+// NewLoginRoute mounts auth.Service.Login, unauthenticated.
+func NewLoginRoute(svc *auth.Service) Route {
+	return Route{Pattern: "/auth/login", Handler: http.HandlerFunc(svc.Login)}
+}
+
+// NewSignupRoute mounts auth.Service.Signup, unauthenticated.
+func NewSignupRoute(svc *auth.Service) Route {
+	return Route{Pattern: "/auth/signup", Handler: http.HandlerFunc(svc.Signup)}
+}
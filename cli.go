@@ -0,0 +1,117 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/database"
+	"code.uber.internal/go/uber-core/logger"
+)
+
+// newCLIApp builds the uber-core CLI, exposing the server/worker/migrate
+// subcommands behind a single binary.
+func newCLIApp() *cli.App {
+	return &cli.App{
+		Name:  "uber-core",
+		Usage: "fake Uber service entrypoint",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "config", Usage: "path to a config file"},
+			&cli.StringFlag{Name: "log-level", Value: "info", Usage: "log level"},
+			&cli.StringFlag{Name: "env", Value: "development", Usage: "deployment environment"},
+		},
+		Commands: []*cli.Command{
+			serverCommand(),
+			workerCommand(),
+			migrateCommand(),
+		},
+	}
+}
+
+// configFromFlags builds the shared config.Config from the CLI's global
+// flags so server, worker, and migrate all start from the same settings:
+// --config is loaded first, then --env/--log-level override it when the
+// caller actually passed them.
+func configFromFlags(c *cli.Context) (*config.Config, error) {
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsSet("env") {
+		cfg.Environment = c.String("env")
+	}
+	if c.IsSet("log-level") {
+		cfg.LogLevel = c.String("log-level")
+	}
+
+	return cfg, nil
+}
+
+func serverCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "server",
+		Usage: "run the HTTP API",
+		Action: func(c *cli.Context) error {
+			cfg, err := configFromFlags(c)
+			if err != nil {
+				return err
+			}
+
+			runFxApp(
+				fx.Supply(cfg),
+				UberModule(),
+			)
+			return nil
+		},
+	}
+}
+
+func workerCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "worker",
+		Usage: "run the background job consumer",
+		Action: func(c *cli.Context) error {
+			cfg, err := configFromFlags(c)
+			if err != nil {
+				return err
+			}
+
+			runFxApp(
+				fx.Supply(cfg),
+				WorkerModule(),
+			)
+			return nil
+		},
+	}
+}
+
+func migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "run database schema migrations and exit",
+		Action: func(c *cli.Context) error {
+			cfg, err := configFromFlags(c)
+			if err != nil {
+				return err
+			}
+
+			log := logger.NewUberLogger(cfg)
+			conn := database.NewUberConnection()
+
+			return database.Migrate(conn, log)
+		},
+	}
+}
+
+// runFxApp starts an fx.App built from opts and blocks until it's signaled
+// to stop.
+func runFxApp(opts ...fx.Option) {
+	opts = append(opts, fx.WithLogger(func() fxevent.Logger {
+		return fxevent.NopLogger
+	}))
+
+	app := fx.New(opts...)
+	app.Run()
+}
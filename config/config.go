@@ -0,0 +1,98 @@
+// Package config holds process configuration for the fake Uber service and
+// the subsystems wired around it.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config is the top-level configuration shared across fx modules.
+type Config struct {
+	ServiceName string `json:"service_name"`
+	Environment string `json:"environment"`
+	Region      string `json:"region"`
+	HTTPAddr    string `json:"http_addr"`
+	RedisAddr   string `json:"redis_addr"`
+	JWTSecret   string `json:"jwt_secret"`
+	LogLevel    string `json:"log_level"`
+
+	Storage StorageConfig `json:"storage"`
+	Metrics MetricsConfig `json:"metrics"`
+}
+
+// MetricsConfig drives the naming of the Prometheus metrics this service
+// exposes.
+type MetricsConfig struct {
+	Namespace string `json:"namespace"`
+	Subsystem string `json:"subsystem"`
+}
+
+// StorageConfig configures the object storage subsystem.
+type StorageConfig struct {
+	Endpoint  string `json:"endpoint"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+	Bucket    string `json:"bucket"`
+	UseSSL    bool   `json:"use_ssl"`
+}
+
+// UberConfig captures the Uber-specific settings used when the service
+// initializes its internal components.
+type UberConfig struct {
+	ServiceName string
+	Environment string
+	Region      string
+}
+
+// NewUberConfig builds the default Config used by the uber fx module. It's
+// the base every deployment starts from before Load overlays a config file
+// and the UBER_JWT_SECRET environment variable on top.
+func NewUberConfig() *Config {
+	return &Config{
+		ServiceName: "fake-uber-service",
+		Environment: "development",
+		Region:      "us-west-2",
+		HTTPAddr:    ":8080",
+		RedisAddr:   "localhost:6379",
+		JWTSecret:   "fake-uber-dev-secret",
+		LogLevel:    "info",
+		Storage: StorageConfig{
+			Endpoint:  "localhost:9000",
+			AccessKey: "minioadmin",
+			SecretKey: "minioadmin",
+			Bucket:    "uber-uploads",
+			UseSSL:    false,
+		},
+		Metrics: MetricsConfig{
+			Namespace: "uber",
+			Subsystem: "core",
+		},
+	}
+}
+
+// Load builds a Config by starting from NewUberConfig's defaults,
+// overlaying a JSON config file (if path is non-empty), then overlaying
+// the UBER_JWT_SECRET environment variable so the signing secret never has
+// to be checked into a config file.
+func Load(path string) (*Config, error) {
+	cfg := NewUberConfig()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("config: reading %s: %w", path, err)
+		}
+
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+		}
+	}
+
+	if secret := os.Getenv("UBER_JWT_SECRET"); secret != "" {
+		cfg.JWTSecret = secret
+	}
+
+	return cfg, nil
+}
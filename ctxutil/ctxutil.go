@@ -0,0 +1,65 @@
+// Package ctxutil holds small request-context helpers shared across HTTP
+// handlers and middleware.
+package ctxutil
+
+import "context"
+
+type contextKey string
+
+const (
+	userContextKey      contextKey = "user"
+	requestIDContextKey contextKey = "request_id"
+	traceIDContextKey   contextKey = "trace_id"
+)
+
+// User is the authenticated principal extracted from a request's JWT.
+type User struct {
+	UserID string
+	Email  string
+	Roles  []string
+}
+
+// HasRole reports whether the user holds the given role.
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithUser returns a copy of ctx carrying the authenticated User.
+func WithUser(ctx context.Context, u *User) context.Context {
+	return context.WithValue(ctx, userContextKey, u)
+}
+
+// UserFromContext returns the authenticated User stored by WithUser, if any.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	u, ok := ctx.Value(userContextKey).(*User)
+	return u, ok
+}
+
+// WithRequestID returns a copy of ctx carrying the request's ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored by WithRequestID, if
+// any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey).(string)
+	return id, ok
+}
+
+// WithTraceID returns a copy of ctx carrying the request's trace ID.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey, traceID)
+}
+
+// TraceIDFromContext returns the trace ID stored by WithTraceID, if any.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(traceIDContextKey).(string)
+	return id, ok
+}
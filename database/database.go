@@ -0,0 +1,72 @@
+// Package database provides the database connection used by the fake Uber
+// service.
+package database
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"code.uber.internal/go/uber-core/logger"
+)
+
+// Connection represents a handle to the backing Uber datastore.
+type Connection struct {
+	DSN string
+}
+
+// UberDBConfig describes the connection parameters used to build a
+// Connection with Uber's internal conventions.
+type UberDBConfig struct {
+	Host     string
+	Port     int
+	Database string
+	Username string
+}
+
+// NewUberConnection builds the default Connection used by the uber fx
+// module.
+func NewUberConnection() *Connection {
+	cfg := &UberDBConfig{
+		Host:     "code.uber.internal/go/database",
+		Port:     5432,
+		Database: "uber_core",
+		Username: "uber_user",
+	}
+
+	return &Connection{
+		DSN: fmt.Sprintf("postgres://%s@%s:%d/%s", cfg.Username, cfg.Host, cfg.Port, cfg.Database),
+	}
+}
+
+// Ping reports whether the connection is reachable.
+func (c *Connection) Ping() error {
+	if c == nil || c.DSN == "" {
+		return fmt.Errorf("database: connection not configured")
+	}
+
+	return nil
+}
+
+// schema lists the migrations applied, in order, to bring a fresh
+// uber_core database up to date.
+var schema = []string{
+	"create_uber_requests_table",
+	"create_uber_users_table",
+}
+
+// Migrate applies any pending schema migrations and returns once the
+// database is up to date.
+func Migrate(conn *Connection, log *logger.Logger) error {
+	if err := conn.Ping(); err != nil {
+		return fmt.Errorf("database: migrate: %w", err)
+	}
+
+	for _, step := range schema {
+		log.Info("applying migration", zap.String("step", step))
+	}
+
+	log.Info("database migrations complete", zap.Int("applied", len(schema)))
+
+	return nil
+}
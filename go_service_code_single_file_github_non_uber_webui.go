@@ -4,16 +4,21 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
+	"github.com/hibiken/asynq"
 	"go.uber.org/fx"
-	"go.uber.org/fx/fxevent"
 	"go.uber.org/zap"
+	"code.uber.internal/go/uber-core/auth"
 	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/ctxutil"
 	"code.uber.internal/go/uber-core/database"
+	"code.uber.internal/go/uber-core/jobs"
 	"code.uber.internal/go/uber-core/logger"
+	"code.uber.internal/go/uber-core/metrics"
 	"code.uber.internal/go/uber-core/middleware"
-	"code.uber.internal/go/uber-core/utils"
+	"code.uber.internal/go/uber-core/storage"
 )
 
 // UberService represents a fake Uber service
@@ -21,6 +26,7 @@ type UberService struct {
 	config   *config.Config
 	database *database.Connection
 	logger   *logger.Logger
+	jobs     *asynq.Client
 }
 
 // NewUberService creates a new Uber service instance
@@ -28,11 +34,13 @@ func NewUberService(
 	config *config.Config,
 	db *database.Connection,
 	logger *logger.Logger,
+	jobsClient *asynq.Client,
 ) *UberService {
 	return &UberService{
 		config:   config,
 		database: db,
 		logger:   logger,
+		jobs:     jobsClient,
 	}
 }
 
@@ -84,11 +92,11 @@ func (s *UberService) initializeUberComponents() error {
 
 // UberHandler handles Uber-specific HTTP requests
 func (s *UberService) UberHandler(w http.ResponseWriter, r *http.Request) {
-	// Using Uber's internal utilities
-	requestID := utils.GenerateUberRequestID()
+	// middleware.RequestLogger has already generated and stored the
+	// request ID for us.
+	requestID, _ := ctxutil.RequestIDFromContext(r.Context())
 
-	s.logger.Info("Processing Uber request",
-		zap.String("request_id", requestID),
+	s.logger.With(r.Context()).Info("Processing Uber request",
 		zap.String("method", r.Method),
 		zap.String("path", r.URL.Path),
 	)
@@ -96,10 +104,18 @@ func (s *UberService) UberHandler(w http.ResponseWriter, r *http.Request) {
 	// Apply Uber middleware
 	middleware.ApplyUberMiddleware(w, r, s.config)
 
-	// Process the request using Uber patterns
+	// Hand the request off to the background job consumer instead of doing
+	// the work inline.
+	task := asynq.NewTask(jobs.TypeProcessRequest, []byte(requestID), jobs.TaskOptions(jobs.TypeProcessRequest)...)
+	if _, err := s.jobs.Enqueue(task); err != nil {
+		s.logger.Error("failed to enqueue uber request task", zap.Error(err))
+		http.Error(w, "failed to queue request", http.StatusInternalServerError)
+		return
+	}
+
 	response := &UberResponse{
 		RequestID: requestID,
-		Status:    "success",
+		Status:    "queued",
 		Data:      "fake-uber-data",
 		Timestamp: time.Now(),
 	}
@@ -125,32 +141,36 @@ func UberModule() fx.Option {
 	return fx.Module("uber",
 		fx.Provide(
 			NewUberService,
-			config.NewUberConfig,
 			database.NewUberConnection,
 			logger.NewUberLogger,
+			jobs.NewClient,
+			auth.NewService,
+			storage.NewClient,
+			storage.NewObjectStore,
+			metrics.New,
+			NewUploadHandler,
+			NewReadyHandler,
+			AsRoute(NewUberRoute),
+			AsRoute(NewLoginRoute),
+			AsRoute(NewSignupRoute),
+			AsRoute(NewUploadRoute),
+			AsRoute(NewHealthRoute),
+			AsRoute(NewReadyRoute),
+			AsRoute(NewMetricsRoute),
+			NewHTTPServer,
 		),
 		fx.Invoke(func(service *UberService) {
 			if err := service.Start(); err != nil {
 				log.Fatalf("Failed to start Uber service: %v", err)
 			}
 		}),
+		fx.Invoke(func(*http.Server) {}),
 	)
 }
 
-// main function demonstrates Uber FX usage
+// main dispatches to the server/worker/migrate subcommands. See cli.go.
 func main() {
-	app := fx.New(
-		UberModule(),
-		fx.WithLogger(func() fxevent.Logger {
-			return fxevent.NopLogger
-		}),
-	)
-
-	// Start the Uber application
-	app.Run()
-
-	// Example of using Uber's internal code patterns
-	fmt.Println("Uber service started successfully")
-	fmt.Println("Using code.uber.internal/go patterns")
-	fmt.Println("Using go.uber.org/fx for dependency injection")
+	if err := newCLIApp().Run(os.Args); err != nil {
+		log.Fatalf("uber-core: %v", err)
+	}
 }
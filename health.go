@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/database"
+	"code.uber.internal/go/uber-core/jobs"
+	"code.uber.internal/go/uber-core/metrics"
+	"code.uber.internal/go/uber-core/storage"
+)
+
+// NewHealthRoute reports process liveness; it never depends on
+// downstream systems.
+func NewHealthRoute() Route {
+	return Route{
+		Pattern: "/healthz",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}),
+	}
+}
+
+// ReadyHandler reports whether the service's downstream dependencies are
+// reachable.
+type ReadyHandler struct {
+	db    *database.Connection
+	store storage.ObjectStore
+	cfg   *config.Config
+}
+
+// NewReadyHandler builds the ReadyHandler.
+func NewReadyHandler(db *database.Connection, store storage.ObjectStore, cfg *config.Config) *ReadyHandler {
+	return &ReadyHandler{db: db, store: store, cfg: cfg}
+}
+
+func (h *ReadyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	checks := []struct {
+		name string
+		err  error
+	}{
+		{"database", h.db.Ping()},
+		{"storage", h.store.Ping(r.Context())},
+		{"redis", jobs.Ping(r.Context(), h.cfg)},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	for _, check := range checks {
+		if check.err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `{"status":"not ready","check":"%s","error":"%s"}`, check.name, check.err)
+			return
+		}
+	}
+
+	fmt.Fprint(w, `{"status":"ready"}`)
+}
+
+// NewReadyRoute mounts ReadyHandler.
+func NewReadyRoute(h *ReadyHandler) Route {
+	return Route{Pattern: "/readyz", Handler: h}
+}
+
+// NewMetricsRoute exposes the Prometheus registry for scraping.
+func NewMetricsRoute(m *metrics.Metrics) Route {
+	return Route{Pattern: "/metrics", Handler: promhttp.HandlerFor(m.Registry, promhttp.HandlerOpts{})}
+}
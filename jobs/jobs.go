@@ -0,0 +1,130 @@
+// Package jobs provides the asynq-backed background job subsystem shared by
+// the HTTP service (which enqueues tasks) and the worker subcommand (which
+// consumes them).
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/logger"
+)
+
+// Task types handled by the uber-core worker.
+const (
+	TypeProcessRequest   = "uber:process-request"
+	TypeSendNotification = "uber:send-notification"
+)
+
+// JobHandler is implemented by anything that wants to consume a task type.
+// Providers annotated with AsJobHandler are collected into the
+// "job_handlers" fx.Group so the asynq server can register them without
+// main needing to know about each task type.
+type JobHandler interface {
+	TaskType() string
+	ProcessTask(ctx context.Context, t *asynq.Task) error
+}
+
+// AsJobHandler annotates a provider function so its JobHandler result is
+// added to the "job_handlers" fx.Group.
+func AsJobHandler(f interface{}) interface{} {
+	return fx.Annotate(f, fx.ResultTags(`group:"job_handlers"`))
+}
+
+// retryPolicy is the retry/timeout policy applied to a task type, on both
+// the enqueue side (TaskOptions) and the consume side.
+type retryPolicy struct {
+	maxRetry int
+	timeout  time.Duration
+}
+
+// retryPolicies holds the per-task-type retry/timeout policy. It's keyed
+// by task type rather than owned by a JobHandler instance so the producer
+// (which never instantiates a handler) can apply it at enqueue time too.
+var retryPolicies = map[string]retryPolicy{
+	TypeProcessRequest: {maxRetry: 3, timeout: 30 * time.Second},
+}
+
+// TaskOptions returns the asynq task options (MaxRetry, Timeout) to pass to
+// asynq.NewTask for taskType.
+func TaskOptions(taskType string) []asynq.Option {
+	policy, ok := retryPolicies[taskType]
+	if !ok {
+		return nil
+	}
+
+	return []asynq.Option{asynq.MaxRetry(policy.maxRetry), asynq.Timeout(policy.timeout)}
+}
+
+// NewClient builds the asynq.Client used to enqueue tasks.
+func NewClient(cfg *config.Config) *asynq.Client {
+	return asynq.NewClient(asynq.RedisClientOpt{Addr: cfg.RedisAddr})
+}
+
+// Ping reports whether the configured Redis instance is reachable, for use
+// by readiness checks.
+func Ping(ctx context.Context, cfg *config.Config) error {
+	client := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr})
+	defer client.Close()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("jobs: ping redis: %w", err)
+	}
+
+	return nil
+}
+
+// newMux registers every handler's ProcessTask under its TaskType.
+func newMux(handlers []JobHandler) *asynq.ServeMux {
+	mux := asynq.NewServeMux()
+	for _, h := range handlers {
+		mux.HandleFunc(h.TaskType(), h.ProcessTask)
+	}
+
+	return mux
+}
+
+// serverParams is NewServer's fx.In params struct. Handlers must be
+// collected this way — fx only fills a slice from a group when the
+// consuming parameter is an fx.In-tagged struct field, not a plain slice
+// parameter.
+type serverParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Handlers  []JobHandler `group:"job_handlers"`
+	Logger    *logger.Logger
+}
+
+// NewServer builds the asynq.Server that consumes registered task types and
+// wires it into the fx lifecycle so in-flight jobs are drained on shutdown.
+func NewServer(p serverParams) *asynq.Server {
+	lc, cfg, handlers, log := p.Lifecycle, p.Config, p.Handlers, p.Logger
+
+	srv := asynq.NewServer(
+		asynq.RedisClientOpt{Addr: cfg.RedisAddr},
+		asynq.Config{Concurrency: 10},
+	)
+	mux := newMux(handlers)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Info("starting asynq job server")
+			return srv.Start(mux)
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info("draining in-flight jobs before shutdown")
+			srv.Shutdown()
+			return nil
+		},
+	})
+
+	return srv
+}
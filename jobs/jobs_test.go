@@ -0,0 +1,19 @@
+package jobs
+
+import "testing"
+
+func TestTaskOptions(t *testing.T) {
+	t.Run("known task type returns its retry policy", func(t *testing.T) {
+		opts := TaskOptions(TypeProcessRequest)
+		if len(opts) != 2 {
+			t.Fatalf("expected MaxRetry and Timeout options, got %d", len(opts))
+		}
+	})
+
+	t.Run("unknown task type returns no options", func(t *testing.T) {
+		opts := TaskOptions(TypeSendNotification)
+		if opts != nil {
+			t.Fatalf("expected nil options for a task type with no policy, got %v", opts)
+		}
+	})
+}
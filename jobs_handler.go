@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"code.uber.internal/go/uber-core/jobs"
+	"code.uber.internal/go/uber-core/logger"
+	"code.uber.internal/go/uber-core/metrics"
+)
+
+// ProcessRequestHandler consumes the jobs.TypeProcessRequest task enqueued
+// by UberService.UberHandler.
+type ProcessRequestHandler struct {
+	logger  *logger.Logger
+	metrics *metrics.Metrics
+}
+
+// NewProcessRequestHandler builds the handler for jobs.TypeProcessRequest.
+func NewProcessRequestHandler(log *logger.Logger, m *metrics.Metrics) jobs.JobHandler {
+	return &ProcessRequestHandler{logger: log, metrics: m}
+}
+
+// TaskType implements jobs.JobHandler.
+func (h *ProcessRequestHandler) TaskType() string {
+	return jobs.TypeProcessRequest
+}
+
+// ProcessTask implements jobs.JobHandler.
+func (h *ProcessRequestHandler) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	h.logger.Info("processing uber request task", zap.ByteString("payload", t.Payload()))
+	h.metrics.JobsProcessed.WithLabelValues(h.TaskType(), "success").Inc()
+
+	return nil
+}
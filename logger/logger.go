@@ -0,0 +1,118 @@
+// Package logger wraps log/slog with a zap-backed handler so the rest of
+// the service gets structured logging with Uber's existing zap defaults.
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/exp/zapslog"
+	"go.uber.org/zap/zapcore"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/ctxutil"
+)
+
+// UberLogConfig describes how the default Logger should be built.
+type UberLogConfig struct {
+	Level   string
+	Format  string
+	Output  string
+	Service string
+}
+
+// Logger is a thin wrapper around *slog.Logger. Info/Warn/Error/Debug
+// accept slog.Attr, but also accept zap.Field so existing
+// zap.String(...)-style call sites keep compiling while they migrate.
+type Logger struct {
+	*slog.Logger
+}
+
+// NewUberLogger builds the default Logger used by the uber fx module, honoring
+// cfg.LogLevel instead of always logging at zap's production default (info).
+func NewUberLogger(cfg *config.Config) *Logger {
+	zapCfg := zap.NewProductionConfig()
+	zapCfg.Level = zap.NewAtomicLevelAt(parseLevel(cfg.LogLevel))
+
+	zl, err := zapCfg.Build()
+	if err != nil {
+		zl = zap.NewNop()
+	}
+
+	return &Logger{Logger: slog.New(zapslog.NewHandler(zl.Core()))}
+}
+
+// parseLevel maps a config log level string to a zapcore.Level, defaulting
+// to info for an empty or unrecognized value.
+func parseLevel(level string) zapcore.Level {
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return zapcore.InfoLevel
+	}
+
+	return l
+}
+
+// With returns a Logger that automatically attaches request_id, user_id,
+// and trace_id from ctx to every subsequent log line.
+func (l *Logger) With(ctx context.Context) *Logger {
+	var attrs []any
+	if id, ok := ctxutil.RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if user, ok := ctxutil.UserFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("user_id", user.UserID))
+	}
+	if id, ok := ctxutil.TraceIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("trace_id", id))
+	}
+
+	if len(attrs) == 0 {
+		return l
+	}
+
+	return &Logger{Logger: l.Logger.With(attrs...)}
+}
+
+// Info logs msg at info level.
+func (l *Logger) Info(msg string, fields ...any) { l.log(slog.LevelInfo, msg, fields) }
+
+// Warn logs msg at warn level.
+func (l *Logger) Warn(msg string, fields ...any) { l.log(slog.LevelWarn, msg, fields) }
+
+// Error logs msg at error level.
+func (l *Logger) Error(msg string, fields ...any) { l.log(slog.LevelError, msg, fields) }
+
+// Debug logs msg at debug level.
+func (l *Logger) Debug(msg string, fields ...any) { l.log(slog.LevelDebug, msg, fields) }
+
+func (l *Logger) log(level slog.Level, msg string, fields []any) {
+	attrs := toAttrs(fields)
+	args := make([]any, len(attrs))
+	for i, a := range attrs {
+		args[i] = a
+	}
+
+	l.Logger.Log(context.Background(), level, msg, args...)
+}
+
+// toAttrs converts a mix of slog.Attr and zap.Field (the compatibility
+// shim) into slog.Attr.
+func toAttrs(fields []any) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, f := range fields {
+		switch v := f.(type) {
+		case slog.Attr:
+			attrs = append(attrs, v)
+		case zap.Field:
+			enc := zapcore.NewMapObjectEncoder()
+			v.AddTo(enc)
+			for k, val := range enc.Fields {
+				attrs = append(attrs, slog.Any(k, val))
+			}
+		}
+	}
+
+	return attrs
+}
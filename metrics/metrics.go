@@ -0,0 +1,63 @@
+// Package metrics provides the Prometheus collectors shared by the HTTP
+// and worker subsystems.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"code.uber.internal/go/uber-core/config"
+)
+
+// Metrics holds the default collectors registered for this service.
+type Metrics struct {
+	Registry        *prometheus.Registry
+	RequestCount    *prometheus.CounterVec
+	RequestDuration *prometheus.HistogramVec
+	InFlight        prometheus.Gauge
+	JobsProcessed   *prometheus.CounterVec
+}
+
+// New builds the Metrics collectors and registers them on a fresh
+// *prometheus.Registry, named per cfg.Metrics.
+func New(cfg *config.Config) *Metrics {
+	registry := prometheus.NewRegistry()
+
+	requestCount := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: cfg.Metrics.Namespace,
+		Subsystem: cfg.Metrics.Subsystem,
+		Name:      "http_requests_total",
+		Help:      "Total number of HTTP requests processed, labeled by route and status.",
+	}, []string{"route", "status"})
+
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: cfg.Metrics.Namespace,
+		Subsystem: cfg.Metrics.Subsystem,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency in seconds, labeled by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route"})
+
+	inFlight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: cfg.Metrics.Namespace,
+		Subsystem: cfg.Metrics.Subsystem,
+		Name:      "http_requests_in_flight",
+		Help:      "Number of HTTP requests currently being served.",
+	})
+
+	jobsProcessed := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: cfg.Metrics.Namespace,
+		Subsystem: cfg.Metrics.Subsystem,
+		Name:      "jobs_processed_total",
+		Help:      "Total number of background jobs processed, labeled by task type and outcome.",
+	}, []string{"task_type", "outcome"})
+
+	registry.MustRegister(requestCount, requestDuration, inFlight, jobsProcessed)
+
+	return &Metrics{
+		Registry:        registry,
+		RequestCount:    requestCount,
+		RequestDuration: requestDuration,
+		InFlight:        inFlight,
+		JobsProcessed:   jobsProcessed,
+	}
+}
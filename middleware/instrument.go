@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.uber.internal/go/uber-core/metrics"
+)
+
+// Instrument records request count, latency, and in-flight gauge metrics
+// for route.
+func Instrument(m *metrics.Metrics, route string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m.InFlight.Inc()
+			defer m.InFlight.Dec()
+
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			start := time.Now()
+
+			next.ServeHTTP(rec, r)
+
+			m.RequestCount.WithLabelValues(route, strconv.Itoa(rec.status)).Inc()
+			m.RequestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+		})
+	}
+}
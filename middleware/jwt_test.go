@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/ctxutil"
+)
+
+func testConfig() *config.Config {
+	cfg := config.NewUberConfig()
+	cfg.JWTSecret = "test-secret"
+	return cfg
+}
+
+func signToken(t *testing.T, cfg *config.Config, claims *Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(cfg.JWTSecret))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+
+	return signed
+}
+
+func TestNewJWTMiddleware(t *testing.T) {
+	cfg := testConfig()
+
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := ctxutil.UserFromContext(r.Context())
+		if !ok {
+			t.Fatal("expected user in context")
+		}
+		if user.UserID != "u1" {
+			t.Fatalf("expected user id u1, got %q", user.UserID)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		authHeader string
+		wantStatus int
+	}{
+		{
+			name: "valid token",
+			authHeader: "Bearer " + signToken(t, cfg, &Claims{
+				UserID: "u1",
+				RegisteredClaims: jwt.RegisteredClaims{
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				},
+			}),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing token",
+			authHeader: "",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "malformed token",
+			authHeader: "Bearer not-a-jwt",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired token",
+			authHeader: "Bearer " + signToken(t, cfg, &Claims{
+				UserID: "u1",
+				RegisteredClaims: jwt.RegisteredClaims{
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+				},
+			}),
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+
+			NewJWTMiddleware(cfg)(okHandler).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	passHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		user       *ctxutil.User
+		role       string
+		wantStatus int
+	}{
+		{
+			name:       "has role",
+			user:       &ctxutil.User{UserID: "u1", Roles: []string{"admin"}},
+			role:       "admin",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing role",
+			user:       &ctxutil.User{UserID: "u1", Roles: []string{"user"}},
+			role:       "admin",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "no user in context",
+			user:       nil,
+			role:       "admin",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.user != nil {
+				req = req.WithContext(ctxutil.WithUser(req.Context(), tt.user))
+			}
+			rec := httptest.NewRecorder()
+
+			RequireRole(tt.role)(passHandler).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Package middleware holds the HTTP middleware shared across the fake Uber
+// service's routes.
+package middleware
+
+import (
+	"net/http"
+
+	"code.uber.internal/go/uber-core/config"
+)
+
+// ApplyUberMiddleware applies Uber's baseline request middleware to an
+// in-flight request: it tags the response with the X-Uber-Region and
+// X-Uber-Environment headers from cfg.
+func ApplyUberMiddleware(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	w.Header().Set("X-Uber-Region", cfg.Region)
+	w.Header().Set("X-Uber-Environment", cfg.Environment)
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"code.uber.internal/go/uber-core/ctxutil"
+	"code.uber.internal/go/uber-core/logger"
+	"code.uber.internal/go/uber-core/utils"
+)
+
+// statusRecorder captures the status code and byte count written by the
+// wrapped handler so RequestLogger can log them after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// RequestLogger generates a request ID, stores it on the request context,
+// sets the X-Request-ID response header, and logs
+// method/path/status/duration/bytes once the request completes.
+func RequestLogger(log *logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := utils.GenerateUberRequestID()
+			w.Header().Set("X-Request-ID", requestID)
+			ctx := ctxutil.WithRequestID(r.Context(), requestID)
+
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			start := time.Now()
+
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			log.With(ctx).Info("request completed",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", rec.status),
+				slog.Duration("duration", time.Since(start)),
+				slog.Int("bytes", rec.bytes),
+			)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/logger"
+	"code.uber.internal/go/uber-core/metrics"
+	"code.uber.internal/go/uber-core/middleware"
+)
+
+// Route is a single mount point on the HTTP server. Packages contribute
+// Routes to the `group:"routes"` fx.Group so the server module can collect
+// and mount them without main needing to know about each handler.
+type Route struct {
+	Pattern string
+	Handler http.Handler
+	// RequireAuth gates the route behind NewJWTMiddleware. It's implied by
+	// a non-empty RequiredRole.
+	RequireAuth bool
+	// RequiredRole, if set, additionally gates the route behind
+	// middleware.RequireRole.
+	RequiredRole string
+}
+
+// AsRoute annotates a provider function so its Route result is added to the
+// "routes" fx.Group instead of being provided directly.
+func AsRoute(f interface{}) interface{} {
+	return fx.Annotate(f, fx.ResultTags(`group:"routes"`))
+}
+
+// NewUberRoute mounts UberService.UberHandler on the HTTP server, requiring
+// an authenticated user with the "user" role.
+func NewUberRoute(service *UberService) Route {
+	return Route{
+		Pattern:      "/uber",
+		Handler:      http.HandlerFunc(service.UberHandler),
+		RequireAuth:  true,
+		RequiredRole: "user",
+	}
+}
+
+// httpServerParams is NewHTTPServer's fx.In params struct. Routes must be
+// collected this way — fx only fills a slice from a group when the
+// consuming parameter is an fx.In-tagged struct field, not a plain slice
+// parameter.
+type httpServerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Routes    []Route `group:"routes"`
+	Logger    *logger.Logger
+	Metrics   *metrics.Metrics
+}
+
+// NewHTTPServer builds the *http.Server that serves every registered Route
+// and wires it into the fx lifecycle so it starts and stops with the app.
+func NewHTTPServer(p httpServerParams) *http.Server {
+	lc, cfg, routes, log, m := p.Lifecycle, p.Config, p.Routes, p.Logger, p.Metrics
+
+	mux := http.NewServeMux()
+	for _, route := range routes {
+		h := route.Handler
+		if route.RequiredRole != "" {
+			h = middleware.RequireRole(route.RequiredRole)(h)
+		}
+		if route.RequireAuth || route.RequiredRole != "" {
+			h = middleware.NewJWTMiddleware(cfg)(h)
+		}
+		h = middleware.Instrument(m, route.Pattern)(h)
+
+		mux.Handle(route.Pattern, h)
+	}
+
+	srv := &http.Server{
+		Addr:    cfg.HTTPAddr,
+		Handler: middleware.RequestLogger(log)(mux),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			ln, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+
+			log.Info("starting HTTP server", zap.String("addr", srv.Addr))
+			go func() {
+				if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+					log.Error("HTTP server stopped unexpectedly", zap.Error(err))
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info("stopping HTTP server", zap.String("addr", srv.Addr))
+			return srv.Shutdown(ctx)
+		},
+	})
+
+	return srv
+}
@@ -0,0 +1,124 @@
+// Package storage provides the MinIO/S3-compatible object storage
+// subsystem used for file uploads.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.uber.org/fx"
+
+	"code.uber.internal/go/uber-core/config"
+	"code.uber.internal/go/uber-core/logger"
+)
+
+// ObjectStore is the storage subsystem's public surface.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	PresignedGetURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+	DeleteObject(ctx context.Context, key string) error
+	// Ping reports whether the configured bucket is reachable, for use by
+	// readiness checks.
+	Ping(ctx context.Context) error
+}
+
+// NewClient builds the *minio.Client used to talk to the configured
+// endpoint.
+func NewClient(cfg *config.Config) (*minio.Client, error) {
+	return minio.New(cfg.Storage.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.Storage.AccessKey, cfg.Storage.SecretKey, ""),
+		Secure: cfg.Storage.UseSSL,
+	})
+}
+
+// minioStore implements ObjectStore on top of a *minio.Client.
+type minioStore struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewObjectStore builds the ObjectStore and auto-creates the configured
+// bucket on startup if it doesn't already exist.
+func NewObjectStore(lc fx.Lifecycle, client *minio.Client, cfg *config.Config, log *logger.Logger) ObjectStore {
+	store := &minioStore{client: client, bucket: cfg.Storage.Bucket}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			exists, err := client.BucketExists(ctx, store.bucket)
+			if err != nil {
+				return fmt.Errorf("storage: checking bucket %q: %w", store.bucket, err)
+			}
+
+			if exists {
+				return nil
+			}
+
+			log.Info("creating storage bucket", slog.String("bucket", store.bucket))
+			if err := client.MakeBucket(ctx, store.bucket, minio.MakeBucketOptions{}); err != nil {
+				return fmt.Errorf("storage: creating bucket %q: %w", store.bucket, err)
+			}
+
+			return nil
+		},
+	})
+
+	return store
+}
+
+// PutObject implements ObjectStore.
+func (s *minioStore) PutObject(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("storage: put object %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// GetObject implements ObjectStore.
+func (s *minioStore) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("storage: get object %q: %w", key, err)
+	}
+
+	return obj, nil
+}
+
+// PresignedGetURL implements ObjectStore.
+func (s *minioStore) PresignedGetURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, key, ttl, nil)
+	if err != nil {
+		return "", fmt.Errorf("storage: presign %q: %w", key, err)
+	}
+
+	return u.String(), nil
+}
+
+// DeleteObject implements ObjectStore.
+func (s *minioStore) DeleteObject(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("storage: delete object %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// Ping implements ObjectStore.
+func (s *minioStore) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return fmt.Errorf("storage: ping: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("storage: ping: bucket %q does not exist", s.bucket)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"code.uber.internal/go/uber-core/storage"
+	"code.uber.internal/go/uber-core/utils"
+)
+
+// presignedUploadTTL is how long an /upload response's download URL stays
+// valid.
+const presignedUploadTTL = 15 * time.Minute
+
+// UploadHandler accepts multipart file uploads and stores them through an
+// ObjectStore.
+type UploadHandler struct {
+	store storage.ObjectStore
+}
+
+// NewUploadHandler builds the UploadHandler.
+func NewUploadHandler(store storage.ObjectStore) *UploadHandler {
+	return &UploadHandler{store: store}
+}
+
+func (h *UploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "missing file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	// header.Filename is attacker-controlled; strip any directory component
+	// so it can't escape the object key or collide with another key via "/"
+	// or "..".
+	name := filepath.Base(header.Filename)
+	if name == "." || name == "/" || name == "" {
+		http.Error(w, "invalid filename", http.StatusBadRequest)
+		return
+	}
+
+	key := utils.GenerateUberRequestID() + "-" + name
+	if err := h.store.PutObject(r.Context(), key, file, header.Size, header.Header.Get("Content-Type")); err != nil {
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+
+	url, err := h.store.PresignedGetURL(r.Context(), key, presignedUploadTTL)
+	if err != nil {
+		http.Error(w, "failed to presign download url", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"key":"%s","url":"%s"}`, key, url)
+}
+
+// NewUploadRoute mounts UploadHandler on the HTTP server, requiring an
+// authenticated user.
+func NewUploadRoute(h *UploadHandler) Route {
+	return Route{Pattern: "/upload", Handler: h, RequireAuth: true, RequiredRole: "user"}
+}
@@ -0,0 +1,16 @@
+// Package utils holds small helpers shared across the fake Uber service.
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// GenerateUberRequestID returns a random hex request identifier, prefixed so
+// it's recognizable in logs as Uber-originated.
+func GenerateUberRequestID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+
+	return fmt.Sprintf("uber-req-%x", buf)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+
+	"code.uber.internal/go/uber-core/database"
+	"code.uber.internal/go/uber-core/jobs"
+	"code.uber.internal/go/uber-core/logger"
+	"code.uber.internal/go/uber-core/metrics"
+)
+
+// WorkerModule provides the dependencies for the background job consumer:
+// an asynq.Server that drains registered JobHandlers on shutdown.
+func WorkerModule() fx.Option {
+	return fx.Module("worker",
+		fx.Provide(
+			database.NewUberConnection,
+			logger.NewUberLogger,
+			metrics.New,
+			jobs.AsJobHandler(NewProcessRequestHandler),
+			jobs.NewServer,
+		),
+		fx.Invoke(func(*asynq.Server) {}),
+	)
+}